@@ -0,0 +1,124 @@
+package main
+
+import "sync"
+
+// ARCCache is an Adaptive Replacement Cache (Megiddo & Modha). It tracks
+// two LRU lists of cached entries - T1 (seen once, recency) and T2 (seen
+// more than once, frequency) - each backed by a ghost list of evicted keys
+// (B1, B2). Ghost hits adapt the target size p of T1 so the cache leans
+// toward whichever of recency or frequency the workload rewards.
+type ARCCache struct {
+	size int
+	p    int // target size of t1
+	t1   *simpleLRU
+	t2   *simpleLRU
+	b1   *simpleLRU
+	b2   *simpleLRU
+	lock sync.Mutex
+}
+
+// NewARCCache creates an ARC cache with the given total capacity.
+func NewARCCache(size int) *ARCCache {
+	return &ARCCache{
+		size: size,
+		t1:   newSimpleLRU(0),
+		t2:   newSimpleLRU(0),
+		b1:   newSimpleLRU(0),
+		b2:   newSimpleLRU(0),
+	}
+}
+
+// Get retrieves an item, promoting a T1 (recency) hit to T2 (frequency).
+func (c *ARCCache) Get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if val, ok := c.t1.remove(key); ok {
+		c.t2.set(key, val)
+		return val, true
+	}
+	if val, ok := c.t2.get(key); ok {
+		return val, true
+	}
+	return nil, false
+}
+
+// Set adds or updates an item, running the full ARC admission algorithm:
+// a ghost hit in B1 or B2 adapts p before promoting the entry into T2.
+func (c *ARCCache) Set(key string, value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.t1.remove(key); ok {
+		c.t2.set(key, value)
+		return
+	}
+	if c.t2.contains(key) {
+		c.t2.set(key, value)
+		return
+	}
+
+	if c.b1.contains(key) {
+		ratio := max(1, c.b2.len()/max(1, c.b1.len()))
+		c.p = min(c.size, c.p+ratio)
+		c.replace(false)
+		c.b1.remove(key)
+		c.t2.set(key, value)
+		return
+	}
+	if c.b2.contains(key) {
+		ratio := max(1, c.b1.len()/max(1, c.b2.len()))
+		c.p = max(0, c.p-ratio)
+		c.replace(true)
+		c.b2.remove(key)
+		c.t2.set(key, value)
+		return
+	}
+
+	// Brand new key.
+	if c.t1.len()+c.t2.len() >= c.size {
+		c.replace(false)
+	}
+	if c.b1.len() > c.size-c.p {
+		c.b1.removeOldest()
+	}
+	if c.b2.len() > c.p {
+		c.b2.removeOldest()
+	}
+	c.t1.set(key, value)
+}
+
+// replace evicts one entry from T1 or T2 into its ghost list, favoring T1
+// when it has grown past its target size p (or is at p on a B2 hit).
+func (c *ARCCache) replace(b2Hit bool) {
+	t1Len := c.t1.len()
+	if t1Len > 0 && (t1Len > c.p || (t1Len == c.p && b2Hit)) {
+		if k, _, ok := c.t1.removeOldest(); ok {
+			c.b1.set(k, nil)
+		}
+		return
+	}
+	if k, _, ok := c.t2.removeOldest(); ok {
+		c.b2.set(k, nil)
+	}
+}
+
+// Len returns the number of items currently cached (T1 + T2).
+func (c *ARCCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.t1.len() + c.t2.len()
+}
+
+// Clear empties the cache, including its ghost lists and the adaptive
+// parameter p.
+func (c *ARCCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.t1.clear()
+	c.t2.clear()
+	c.b1.clear()
+	c.b2.clear()
+	c.p = 0
+}
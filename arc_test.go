@@ -0,0 +1,65 @@
+package main
+
+import "testing"
+
+func TestARCCacheGetSetBasic(t *testing.T) {
+	c := NewARCCache(2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %v, %v", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Fatalf("expected len 2, got %d", c.Len())
+	}
+}
+
+func TestARCCacheRepeatedAccessPromotesToFrequent(t *testing.T) {
+	c := NewARCCache(2)
+	c.Set("a", 1)
+	c.Get("a") // T1 hit promotes a to T2
+
+	c.Set("b", 2)
+	c.Set("d", 3) // over capacity, should evict from T1 (b), not T2 (a)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected frequently used a to survive eviction")
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("expected b to have been evicted")
+	}
+}
+
+func TestARCCacheGhostHitAdaptsP(t *testing.T) {
+	c := NewARCCache(2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("d", 3) // evicts a into b1
+
+	if !c.b1.contains("a") {
+		t.Fatal("expected a to be in the b1 ghost list after eviction")
+	}
+
+	c.Set("a", 10) // ghost hit in b1 should adapt p and promote a to T2
+	if c.p == 0 {
+		t.Fatal("expected p to grow after a b1 ghost hit")
+	}
+	if v, ok := c.Get("a"); !ok || v != 10 {
+		t.Fatalf("expected a=10 after ghost promotion, got %v, %v", v, ok)
+	}
+}
+
+func TestARCCacheClear(t *testing.T) {
+	c := NewARCCache(2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Clear()
+
+	if c.Len() != 0 {
+		t.Fatalf("expected len 0 after Clear, got %d", c.Len())
+	}
+	if c.p != 0 {
+		t.Fatalf("expected p reset to 0 after Clear, got %d", c.p)
+	}
+}
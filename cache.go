@@ -0,0 +1,316 @@
+package main
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Policy selects the eviction strategy a Cache uses when it's full.
+type Policy int
+
+const (
+	// PolicyLRU evicts the least recently used entry. Every Get moves the
+	// entry to the front of the list, so reads take the write lock.
+	PolicyLRU Policy = iota
+	// PolicySIEVE evicts using the SIEVE algorithm: a FIFO order with a
+	// one-bit "visited" flag per entry. Get only sets the visited bit, so
+	// reads can run under an RLock.
+	PolicySIEVE
+)
+
+// TypedCache is a fixed-size cache keyed by K, holding values of type V. It
+// defaults to LRU eviction; see NewCacheWithPolicy for alternatives.
+type TypedCache[K comparable, V any] struct {
+	max_size     int
+	curr_size    int
+	cache        map[K]*list.Element
+	order        *list.List
+	hand         *list.Element // SIEVE-only: current position of the "hand"
+	policy       Policy
+	lock         sync.RWMutex
+	default_ttl  time.Duration
+	janitor_stop chan struct{}
+	on_evicted   func(key K, value V)
+	hit_count    atomic.Uint64
+	miss_count   atomic.Uint64
+	evict_count  atomic.Uint64
+}
+
+// Stats holds cumulative counters for a Cache, useful for computing hit
+// ratios and watching eviction pressure in production.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+type typedCacheItem[K comparable, V any] struct {
+	key       K
+	val       V
+	expire_at time.Time   // zero means no expiration
+	visited   atomic.Bool // SIEVE-only: set by Get, cleared by eviction
+}
+
+// Cache is TypedCache instantiated for the string-keyed, untyped-value case
+// that all existing callers use, preserved as the default entry point so
+// code written against the pre-generics Cache/NewCache keeps compiling
+// unchanged. Use NewTypedCache directly for compile-time typed keys/values.
+type Cache = TypedCache[string, any]
+
+// NewCache creates a new LRU cache with given size.
+func NewCache(size int) *Cache {
+	return NewTypedCache[string, any](size)
+}
+
+// NewTypedCache creates a new LRU cache with given size, typed over K and V.
+func NewTypedCache[K comparable, V any](size int) *TypedCache[K, V] {
+	return &TypedCache[K, V]{
+		max_size:  size,
+		curr_size: 0,
+		cache:     make(map[K]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// NewCacheWithEvict creates a new LRU cache that calls onEvicted whenever
+// an entry leaves the cache, whether from capacity eviction, TTL expiry,
+// Remove, or Clear - so callers can close file handles, return items to
+// pools, or push invalidation messages downstream.
+func NewCacheWithEvict[K comparable, V any](size int, onEvicted func(key K, value V)) *TypedCache[K, V] {
+	c := NewTypedCache[K, V](size)
+	c.on_evicted = onEvicted
+	return c
+}
+
+// Stats returns a snapshot of the cache's cumulative hit/miss/eviction
+// counters and its current size.
+func (c *TypedCache[K, V]) Stats() Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return Stats{
+		Hits:      c.hit_count.Load(),
+		Misses:    c.miss_count.Load(),
+		Evictions: c.evict_count.Load(),
+		Size:      c.curr_size,
+	}
+}
+
+// Set adds or updates an item in the cache, using the cache's default TTL
+// (none, unless it was created with NewCacheWithDefaultTTL).
+func (c *TypedCache[K, V]) Set(key K, value V) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.set(key, value, c.expireAt(c.default_ttl))
+}
+
+// set adds or updates an item, expecting the caller to hold c.lock for writing.
+func (c *TypedCache[K, V]) set(key K, value V, expireAt time.Time) {
+	// Check if key already exists
+	if ele, ok := c.cache[key]; ok {
+		item := ele.Value.(*typedCacheItem[K, V])
+		item.val = value
+		item.expire_at = expireAt
+		c.touch(ele, item)
+		return
+	}
+
+	// If we're at capacity, evict according to policy
+	if c.curr_size >= c.max_size {
+		c.evictOne()
+	}
+
+	// Add new item
+	ele := c.order.PushFront(&typedCacheItem[K, V]{key: key, val: value, expire_at: expireAt})
+	c.cache[key] = ele
+	c.curr_size++
+}
+
+// touch records an access to ele for the purposes of eviction: LRU moves it
+// to the front of the list, SIEVE just marks it visited.
+func (c *TypedCache[K, V]) touch(ele *list.Element, item *typedCacheItem[K, V]) {
+	if c.policy == PolicySIEVE {
+		item.visited.Store(true)
+		return
+	}
+	c.order.MoveToFront(ele)
+}
+
+// expireAt returns the deadline for a newly set item given ttl, or the
+// zero time if ttl means "no expiration".
+func (c *TypedCache[K, V]) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
+
+// Get retrieves an item from the cache. An item past its expiration is
+// treated as a miss and evicted.
+func (c *TypedCache[K, V]) Get(key K) (V, bool) {
+	if c.policy == PolicySIEVE {
+		return c.getSieve(key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if ele, ok := c.cache[key]; ok {
+		item := ele.Value.(*typedCacheItem[K, V])
+		if c.expired(item) {
+			c.removeElement(ele)
+			c.miss_count.Add(1)
+			var zero V
+			return zero, false
+		}
+		c.order.MoveToFront(ele)
+		c.hit_count.Add(1)
+		return item.val, true
+	}
+	c.miss_count.Add(1)
+	var zero V
+	return zero, false
+}
+
+// Peek returns an item without marking it as recently used. It still
+// treats an expired item as a miss.
+func (c *TypedCache[K, V]) Peek(key K) (V, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if ele, ok := c.cache[key]; ok {
+		item := ele.Value.(*typedCacheItem[K, V])
+		if c.expired(item) {
+			var zero V
+			return zero, false
+		}
+		return item.val, true
+	}
+	var zero V
+	return zero, false
+}
+
+// expired reports whether item's TTL has passed.
+func (c *TypedCache[K, V]) expired(item *typedCacheItem[K, V]) bool {
+	return !item.expire_at.IsZero() && !item.expire_at.After(time.Now())
+}
+
+// Contains reports whether key is present, without affecting recency.
+func (c *TypedCache[K, V]) Contains(key K) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	_, ok := c.cache[key]
+	return ok
+}
+
+// Remove deletes key from the cache, reporting whether it was present.
+func (c *TypedCache[K, V]) Remove(key K) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if ele, ok := c.cache[key]; ok {
+		c.removeElement(ele)
+		return true
+	}
+	return false
+}
+
+// Keys returns the cache's keys, ordered from least to most recently used
+// (for SIEVE, from oldest to newest inserted).
+func (c *TypedCache[K, V]) Keys() []K {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	keys := make([]K, 0, c.curr_size)
+	for ele := c.order.Back(); ele != nil; ele = ele.Prev() {
+		keys = append(keys, ele.Value.(*typedCacheItem[K, V]).key)
+	}
+	return keys
+}
+
+// evictOne removes one item according to the cache's eviction policy.
+func (c *TypedCache[K, V]) evictOne() {
+	if c.curr_size == 0 {
+		return // shouldn't happen, but just in case
+	}
+
+	if c.policy == PolicySIEVE {
+		c.evictSieve()
+		return
+	}
+
+	if ele := c.order.Back(); ele != nil {
+		c.removeElement(ele)
+	}
+}
+
+// removeElement is a helper to remove an element from the cache. It fires
+// on_evicted, so every removal path - capacity eviction, TTL expiry,
+// Remove, and Clear - notifies callers the same way.
+func (c *TypedCache[K, V]) removeElement(e *list.Element) {
+	if e == c.hand {
+		c.hand = c.prevOrWrap(e)
+	}
+	c.order.Remove(e)
+	kv := e.Value.(*typedCacheItem[K, V])
+	delete(c.cache, kv.key)
+	c.curr_size--
+	c.evict_count.Add(1)
+	if c.on_evicted != nil {
+		c.on_evicted(kv.key, kv.val)
+	}
+}
+
+// Len returns the number of items in the cache.
+func (c *TypedCache[K, V]) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.curr_size
+}
+
+// Clear empties the cache, notifying on_evicted for every entry it removes.
+func (c *TypedCache[K, V]) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for ele := c.order.Front(); ele != nil; {
+		next := ele.Next()
+		c.removeElement(ele)
+		ele = next
+	}
+}
+
+func main() {
+	// Create a small cache
+	c := NewCache(3)
+
+	// Add some items
+	c.Set("name", "John")
+	c.Set("age", 30)
+	c.Set("city", "New York")
+
+	// Try to get an item
+	if val, ok := c.Get("age"); ok {
+		fmt.Printf("Age: %v\n", val)
+	} else {
+		fmt.Println("Age not found")
+	}
+
+	// Add one more item, which should evict the LRU item
+	c.Set("country", "USA")
+
+	// Try to get the evicted item
+	if _, ok := c.Get("name"); !ok {
+		fmt.Println("Name was evicted")
+	}
+
+	fmt.Printf("Cache size: %d\n", c.Len())
+
+	// Clear the cache
+	c.Clear()
+	fmt.Printf("Cache size after clear: %d\n", c.Len())
+}
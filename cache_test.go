@@ -0,0 +1,97 @@
+package main
+
+import "testing"
+
+func TestCachePeekDoesNotAffectRecency(t *testing.T) {
+	c := NewTypedCache[int, string](2)
+	c.Set(1, "a")
+	c.Set(2, "b")
+
+	if v, ok := c.Peek(1); !ok || v != "a" {
+		t.Fatalf("expected Peek(1)=a, got %v, %v", v, ok)
+	}
+
+	// Peek must not have moved 1 to the front, so 1 is still the LRU entry.
+	c.Set(3, "c")
+	if _, ok := c.Get(1); ok {
+		t.Fatal("expected 1 to have been evicted as the least recently used entry")
+	}
+	if _, ok := c.Get(2); !ok {
+		t.Fatal("expected 2 to still be present")
+	}
+}
+
+func TestCachePeekMissing(t *testing.T) {
+	c := NewTypedCache[int, string](2)
+	if _, ok := c.Peek(99); ok {
+		t.Fatal("expected Peek of a missing key to report false")
+	}
+}
+
+func TestCacheContains(t *testing.T) {
+	c := NewTypedCache[int, string](2)
+	c.Set(1, "a")
+
+	if !c.Contains(1) {
+		t.Fatal("expected Contains(1) to be true")
+	}
+	if c.Contains(2) {
+		t.Fatal("expected Contains(2) to be false")
+	}
+}
+
+func TestCacheRemove(t *testing.T) {
+	c := NewTypedCache[int, string](2)
+	c.Set(1, "a")
+
+	if !c.Remove(1) {
+		t.Fatal("expected Remove(1) to report true for a present key")
+	}
+	if c.Remove(1) {
+		t.Fatal("expected a second Remove(1) to report false")
+	}
+	if c.Contains(1) {
+		t.Fatal("expected 1 to be gone after Remove")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected len 0 after removing the only entry, got %d", c.Len())
+	}
+}
+
+func TestCacheKeysOrderedLeastToMostRecentlyUsed(t *testing.T) {
+	c := NewTypedCache[int, string](3)
+	c.Set(1, "a")
+	c.Set(2, "b")
+	c.Set(3, "c")
+	c.Get(1) // moves 1 to the front, leaving 2 as least recently used
+
+	keys := c.Keys()
+	want := []int{2, 3, 1}
+	if len(keys) != len(want) {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+	for i, k := range want {
+		if keys[i] != k {
+			t.Fatalf("expected %v, got %v", want, keys)
+		}
+	}
+}
+
+func TestCacheGenericIntStringInstantiation(t *testing.T) {
+	c := NewTypedCache[int, string](2)
+	c.Set(1, "one")
+	c.Set(2, "two")
+
+	if v, ok := c.Get(1); !ok || v != "one" {
+		t.Fatalf("expected Get(1)=one, got %v, %v", v, ok)
+	}
+
+	c.Set(3, "three") // evicts 2, the least recently used
+
+	if c.Contains(2) {
+		t.Fatal("expected 2 to have been evicted")
+	}
+	if !c.Contains(1) || !c.Contains(3) {
+		t.Fatal("expected 1 and 3 to still be present")
+	}
+}
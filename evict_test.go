@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOnEvictedFiresOnCapacityEviction(t *testing.T) {
+	var evicted []string
+	c := NewCacheWithEvict[string, int](2, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3) // evicts a, the least recently used
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("expected on_evicted to fire once for a, got %v", evicted)
+	}
+}
+
+func TestOnEvictedFiresOnTTLExpiry(t *testing.T) {
+	var evicted []string
+	c := NewCacheWithEvict[string, int](10, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.SetWithTTL("a", 1, 10*time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to be expired")
+	}
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("expected on_evicted to fire once for a, got %v", evicted)
+	}
+}
+
+func TestOnEvictedFiresOnRemove(t *testing.T) {
+	var evicted []string
+	c := NewCacheWithEvict[string, int](10, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Set("a", 1)
+	c.Remove("a")
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("expected on_evicted to fire once for a, got %v", evicted)
+	}
+}
+
+func TestOnEvictedFiresOnClear(t *testing.T) {
+	var evicted []string
+	c := NewCacheWithEvict[string, int](10, func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Clear()
+
+	if len(evicted) != 2 {
+		t.Fatalf("expected on_evicted to fire for both entries, got %v", evicted)
+	}
+}
+
+func TestStatsCountsHitsMissesAndEvictions(t *testing.T) {
+	c := NewCache(2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")       // hit
+	c.Get("missing") // miss
+	c.Set("c", 3)    // evicts b
+
+	stats := c.Stats()
+	if stats.Hits != 1 {
+		t.Fatalf("expected 1 hit, got %d", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Fatalf("expected 1 miss, got %d", stats.Misses)
+	}
+	if stats.Evictions != 1 {
+		t.Fatalf("expected 1 eviction, got %d", stats.Evictions)
+	}
+	if stats.Size != 2 {
+		t.Fatalf("expected size 2, got %d", stats.Size)
+	}
+}
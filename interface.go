@@ -0,0 +1,19 @@
+package main
+
+// Interface is the surface shared by the string-keyed cache
+// implementations: the plain LRU cache (via Cache), ARCCache,
+// TwoQueueCache, and ShardedCache. It lets callers depend on "a cache"
+// without committing to a particular eviction policy.
+type Interface interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{})
+	Len() int
+	Clear()
+}
+
+var (
+	_ Interface = (*Cache)(nil)
+	_ Interface = (*ARCCache)(nil)
+	_ Interface = (*TwoQueueCache)(nil)
+	_ Interface = (*ShardedCache)(nil)
+)
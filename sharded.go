@@ -0,0 +1,77 @@
+package main
+
+import "hash/fnv"
+
+// ShardedCache fans keys across a power-of-two number of independent
+// Cache shards, each with its own lock, so concurrent callers hitting
+// different shards don't contend on a single mutex the way a plain Cache's
+// Get (which mutates the LRU list on every hit) would.
+type ShardedCache struct {
+	shards []*Cache
+	mask   uint32
+}
+
+// NewShardedCache creates a ShardedCache with the given total capacity,
+// split evenly across shards (rounded up to the next power of two).
+func NewShardedCache(totalSize, shards int) *ShardedCache {
+	shards = nextPowerOfTwo(shards)
+	perShard := max(1, totalSize/shards)
+
+	sc := &ShardedCache{
+		shards: make([]*Cache, shards),
+		mask:   uint32(shards - 1),
+	}
+	for i := range sc.shards {
+		sc.shards[i] = NewCache(perShard)
+	}
+	return sc
+}
+
+// shardFor picks the shard responsible for key using an FNV-1a hash.
+func (s *ShardedCache) shardFor(key string) *Cache {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return s.shards[h.Sum32()&s.mask]
+}
+
+// Get retrieves an item from its shard.
+func (s *ShardedCache) Get(key string) (interface{}, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Set adds or updates an item in its shard.
+func (s *ShardedCache) Set(key string, value interface{}) {
+	s.shardFor(key).Set(key, value)
+}
+
+// Remove deletes key from its shard, reporting whether it was present.
+func (s *ShardedCache) Remove(key string) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// Len returns the total number of items across all shards.
+func (s *ShardedCache) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// Clear empties every shard.
+func (s *ShardedCache) Clear() {
+	for _, shard := range s.shards {
+		shard.Clear()
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	if n < 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestShardedCacheGetSet(t *testing.T) {
+	sc := NewShardedCache(16, 4)
+
+	for i := 0; i < 20; i++ {
+		sc.Set(fmt.Sprintf("key-%d", i), i)
+	}
+
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		v, ok := sc.Get(key)
+		if !ok {
+			// Eviction is expected once a shard's share of the capacity
+			// fills up; just make sure it's consistently reported missing.
+			if sc.shardFor(key).Contains(key) {
+				t.Fatalf("Get(%s) missed but the shard still contains it", key)
+			}
+			continue
+		}
+		if v != i {
+			t.Fatalf("Get(%s) = %v, want %d", key, v, i)
+		}
+	}
+}
+
+func TestShardedCacheRouteToSameShardConsistently(t *testing.T) {
+	sc := NewShardedCache(16, 4)
+	key := "consistent-key"
+
+	first := sc.shardFor(key)
+	for i := 0; i < 10; i++ {
+		if sc.shardFor(key) != first {
+			t.Fatal("expected the same key to always route to the same shard")
+		}
+	}
+}
+
+func TestShardedCacheRemove(t *testing.T) {
+	sc := NewShardedCache(16, 4)
+	sc.Set("a", 1)
+
+	if !sc.Remove("a") {
+		t.Fatal("expected Remove(a) to report true for a present key")
+	}
+	if sc.Remove("a") {
+		t.Fatal("expected a second Remove(a) to report false")
+	}
+	if _, ok := sc.Get("a"); ok {
+		t.Fatal("expected a to be gone after Remove")
+	}
+}
+
+func TestShardedCacheLenAcrossShards(t *testing.T) {
+	sc := NewShardedCache(16, 4)
+	for i := 0; i < 8; i++ {
+		sc.Set(fmt.Sprintf("key-%d", i), i)
+	}
+
+	if sc.Len() != 8 {
+		t.Fatalf("expected Len() 8 across all shards, got %d", sc.Len())
+	}
+}
+
+func TestShardedCacheClearEmptiesEveryShard(t *testing.T) {
+	sc := NewShardedCache(16, 4)
+	for i := 0; i < 8; i++ {
+		sc.Set(fmt.Sprintf("key-%d", i), i)
+	}
+
+	sc.Clear()
+
+	if sc.Len() != 0 {
+		t.Fatalf("expected Len() 0 after Clear, got %d", sc.Len())
+	}
+	for _, shard := range sc.shards {
+		if shard.Len() != 0 {
+			t.Fatal("expected every shard to be empty after Clear")
+		}
+	}
+}
+
+func TestShardedCacheConcurrentAccess(t *testing.T) {
+	sc := NewShardedCache(256, 8)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := fmt.Sprintf("worker-%d-key-%d", worker, i)
+				sc.Set(key, i)
+				sc.Get(key)
+				sc.Remove(key)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
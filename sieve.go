@@ -0,0 +1,96 @@
+package main
+
+import "container/list"
+
+// NewCacheWithPolicy creates a new cache with given size using the given
+// eviction policy, keeping the rest of the Cache API unchanged.
+func NewCacheWithPolicy[K comparable, V any](size int, policy Policy) *TypedCache[K, V] {
+	c := NewTypedCache[K, V](size)
+	c.policy = policy
+	return c
+}
+
+// getSieve is Get for a PolicySIEVE cache. The fast path only sets the
+// visited bit, so it can run under an RLock; only an expired entry needs
+// the write lock, to evict it.
+func (c *TypedCache[K, V]) getSieve(key K) (V, bool) {
+	c.lock.RLock()
+	ele, ok := c.cache[key]
+	if !ok {
+		c.lock.RUnlock()
+		c.miss_count.Add(1)
+		var zero V
+		return zero, false
+	}
+	item := ele.Value.(*typedCacheItem[K, V])
+	if c.expired(item) {
+		c.lock.RUnlock()
+		c.miss_count.Add(1)
+		return c.removeIfStillExpired(key)
+	}
+	item.visited.Store(true)
+	val := item.val
+	c.lock.RUnlock()
+	c.hit_count.Add(1)
+	return val, true
+}
+
+// removeIfStillExpired takes the write lock and evicts key if it's still
+// present and still expired, re-checking since the RLock was dropped.
+func (c *TypedCache[K, V]) removeIfStillExpired(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var zero V
+	if ele, ok := c.cache[key]; ok {
+		if item := ele.Value.(*typedCacheItem[K, V]); c.expired(item) {
+			c.removeElement(ele)
+		}
+	}
+	return zero, false
+}
+
+// evictSieve implements the SIEVE eviction algorithm: walk the hand
+// backwards from its current position (wrapping to the back of the FIFO
+// order), clearing visited bits, until an unvisited entry is found; that
+// entry is evicted and the hand is left at its predecessor. If the walk
+// makes a full lap without finding one (every entry, including a lone
+// entry in a size-1 cache, was visited), it stops back at its starting
+// point and evicts that, now-cleared, entry instead of wrapping forever.
+func (c *TypedCache[K, V]) evictSieve() {
+	e := c.hand
+	if e == nil {
+		e = c.order.Back()
+	}
+	start := e
+
+	for {
+		item := e.Value.(*typedCacheItem[K, V])
+		if !item.visited.Load() {
+			break
+		}
+		item.visited.Store(false)
+
+		next := c.prevOrWrap(e)
+		if next == nil || next == start {
+			e = start
+			break
+		}
+		e = next
+	}
+
+	c.hand = c.prevOrWrap(e)
+	c.removeElement(e)
+}
+
+// prevOrWrap returns the element before e in the order list, wrapping
+// around to the back of the list if e is already at the front.
+func (c *TypedCache[K, V]) prevOrWrap(e *list.Element) *list.Element {
+	if prev := e.Prev(); prev != nil {
+		return prev
+	}
+	if back := c.order.Back(); back != e {
+		return back
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestSieveSizeOneEvictsWithoutPanic(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](1, PolicySIEVE)
+	c.Set("a", 1)
+	c.Get("a") // mark "a" visited so eviction has to wrap around it
+	c.Set("b", 2)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to have been evicted")
+	}
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Fatalf("expected b=2 to be present, got %v, %v", v, ok)
+	}
+	if c.Len() != 1 {
+		t.Fatalf("expected size 1, got %d", c.Len())
+	}
+}
+
+func TestSieveEvictsUnvisitedFirst(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](2, PolicySIEVE)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // visit a, leave b unvisited
+
+	c.Set("c", 3) // should evict b, the unvisited entry
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("expected b to have been evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to still be present")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatal("expected c to be present")
+	}
+}
+
+func TestSieveAllVisitedWrapsAndEvicts(t *testing.T) {
+	c := NewCacheWithPolicy[string, int](3, PolicySIEVE)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	c.Get("a")
+	c.Get("b")
+	c.Get("c")
+
+	c.Set("d", 4)
+
+	if c.Len() != 3 {
+		t.Fatalf("expected size 3 after eviction, got %d", c.Len())
+	}
+	if _, ok := c.Get("d"); !ok {
+		t.Fatal("expected d to be present")
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import "container/list"
+
+// simpleLRU is an unsynchronized, string-keyed LRU list used as a building
+// block for the multi-list cache policies (ARC, 2Q). A max_size of 0 means
+// unbounded: callers that need eviction call removeOldest themselves.
+type simpleLRU struct {
+	max_size int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type simpleLRUItem struct {
+	key string
+	val interface{}
+}
+
+func newSimpleLRU(size int) *simpleLRU {
+	return &simpleLRU{
+		max_size: size,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// get returns key's value and moves it to the front of the list.
+func (l *simpleLRU) get(key string) (interface{}, bool) {
+	if ele, ok := l.items[key]; ok {
+		l.order.MoveToFront(ele)
+		return ele.Value.(*simpleLRUItem).val, true
+	}
+	return nil, false
+}
+
+func (l *simpleLRU) contains(key string) bool {
+	_, ok := l.items[key]
+	return ok
+}
+
+// set adds or updates key at the front of the list. If max_size is set and
+// adding a new key pushed the list over it, the oldest entry is evicted.
+func (l *simpleLRU) set(key string, val interface{}) {
+	if ele, ok := l.items[key]; ok {
+		l.order.MoveToFront(ele)
+		ele.Value.(*simpleLRUItem).val = val
+		return
+	}
+
+	ele := l.order.PushFront(&simpleLRUItem{key: key, val: val})
+	l.items[key] = ele
+
+	if l.max_size > 0 && l.order.Len() > l.max_size {
+		l.removeOldest()
+	}
+}
+
+// remove deletes key, reporting its value if it was present.
+func (l *simpleLRU) remove(key string) (interface{}, bool) {
+	if ele, ok := l.items[key]; ok {
+		val := ele.Value.(*simpleLRUItem).val
+		l.removeElement(ele)
+		return val, true
+	}
+	return nil, false
+}
+
+// removeOldest evicts the least recently used entry, reporting its key and value.
+func (l *simpleLRU) removeOldest() (string, interface{}, bool) {
+	ele := l.order.Back()
+	if ele == nil {
+		return "", nil, false
+	}
+	item := ele.Value.(*simpleLRUItem)
+	l.removeElement(ele)
+	return item.key, item.val, true
+}
+
+func (l *simpleLRU) removeElement(e *list.Element) {
+	l.order.Remove(e)
+	item := e.Value.(*simpleLRUItem)
+	delete(l.items, item.key)
+}
+
+func (l *simpleLRU) len() int {
+	return l.order.Len()
+}
+
+func (l *simpleLRU) clear() {
+	l.items = make(map[string]*list.Element)
+	l.order.Init()
+}
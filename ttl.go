@@ -0,0 +1,77 @@
+package main
+
+import "time"
+
+// NewCacheWithDefaultTTL creates a cache whose entries expire after ttl
+// unless overridden per-entry with SetWithTTL, and starts a background
+// janitor goroutine that periodically evicts expired entries so they don't
+// sit in memory until they're next accessed. Callers should call Close
+// when the cache is no longer needed to stop the janitor.
+func NewCacheWithDefaultTTL[K comparable, V any](size int, defaultTTL time.Duration) *TypedCache[K, V] {
+	c := NewTypedCache[K, V](size)
+	c.default_ttl = defaultTTL
+	c.startJanitor(defaultTTL)
+	return c
+}
+
+// SetWithTTL adds or updates an item with a TTL that overrides the cache's
+// default, if any. A ttl of zero (or less) means the entry never expires.
+func (c *TypedCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.set(key, value, c.expireAt(ttl))
+}
+
+// startJanitor launches the background sweep goroutine, if interval is
+// positive. It is a no-op otherwise, leaving expiration purely lazy.
+func (c *TypedCache[K, V]) startJanitor(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	c.janitor_stop = stop
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				c.removeExpired(now)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// removeExpired sweeps the cache for entries past their expiration. It
+// runs under c.lock, the same lock Clear() takes to empty the cache
+// synchronously, so there's no separate generation to race against.
+func (c *TypedCache[K, V]) removeExpired(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for ele := c.order.Back(); ele != nil; {
+		prev := ele.Prev()
+		item := ele.Value.(*typedCacheItem[K, V])
+		if !item.expire_at.IsZero() && !item.expire_at.After(now) {
+			c.removeElement(ele)
+		}
+		ele = prev
+	}
+}
+
+// Close stops the background janitor goroutine, if one is running. It is
+// safe to call on a cache that never started a janitor.
+func (c *TypedCache[K, V]) Close() {
+	c.lock.Lock()
+	stop := c.janitor_stop
+	c.janitor_stop = nil
+	c.lock.Unlock()
+
+	if stop != nil {
+		close(stop)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetWithTTLExpires(t *testing.T) {
+	c := NewTypedCache[string, int](10)
+	c.SetWithTTL("a", 1, 10*time.Millisecond)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to be present immediately after Set")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to be expired")
+	}
+}
+
+func TestSetWithTTLZeroNeverExpires(t *testing.T) {
+	c := NewTypedCache[string, int](10)
+	c.SetWithTTL("a", 1, 0)
+
+	time.Sleep(10 * time.Millisecond)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a with zero TTL to never expire")
+	}
+}
+
+func TestJanitorRemovesExpiredEntries(t *testing.T) {
+	c := NewCacheWithDefaultTTL[string, int](10, 5*time.Millisecond)
+	defer c.Close()
+
+	c.Set("a", 1)
+
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		c.lock.RLock()
+		n := c.curr_size
+		c.lock.RUnlock()
+		if n == 0 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("expected janitor to remove expired entry")
+}
+
+func TestCloseIsSafeWithoutJanitor(t *testing.T) {
+	c := NewTypedCache[string, int](10)
+	c.Close() // no janitor was ever started
+}
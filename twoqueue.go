@@ -0,0 +1,116 @@
+package main
+
+import "sync"
+
+// defaultRecentRatio is the fraction of total size given to the recent-once
+// list by default.
+const defaultRecentRatio = 0.25
+
+// defaultGhostRatio is the fraction of total size given to the ghost list
+// of recently evicted keys by default.
+const defaultGhostRatio = 0.5
+
+// TwoQueueCache is a 2Q cache: entries seen once live in a small "recent"
+// LRU, entries seen more than once are promoted to a larger "frequent" LRU,
+// and keys evicted from "recent" are remembered in a ghost list so that a
+// second access shortly after eviction promotes straight into "frequent"
+// instead of restarting in "recent".
+type TwoQueueCache struct {
+	size        int
+	recent_size int
+	recent      *simpleLRU
+	frequent    *simpleLRU
+	ghost       *simpleLRU
+	lock        sync.Mutex
+}
+
+// New2QCache creates a 2Q cache with the given total capacity, splitting it
+// into a recent-once list (25%), a frequent list (the remainder), and a
+// ghost list of evicted keys (50%).
+func New2QCache(size int) *TwoQueueCache {
+	recent_size := max(1, int(float64(size)*defaultRecentRatio))
+	ghost_size := max(1, int(float64(size)*defaultGhostRatio))
+
+	return &TwoQueueCache{
+		size:        size,
+		recent_size: recent_size,
+		recent:      newSimpleLRU(0),
+		frequent:    newSimpleLRU(0),
+		ghost:       newSimpleLRU(ghost_size),
+	}
+}
+
+// Get retrieves an item, promoting a hit in either list to frequent.
+func (c *TwoQueueCache) Get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if val, ok := c.frequent.get(key); ok {
+		return val, true
+	}
+	if val, ok := c.recent.remove(key); ok {
+		c.frequent.set(key, val)
+		return val, true
+	}
+	return nil, false
+}
+
+// Set adds or updates an item. A key found in the ghost list is promoted
+// straight to frequent; any other new key starts in recent.
+func (c *TwoQueueCache) Set(key string, value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.frequent.contains(key) {
+		c.frequent.set(key, value)
+		return
+	}
+	if _, ok := c.recent.remove(key); ok {
+		c.frequent.set(key, value)
+		c.evictIfFull()
+		return
+	}
+	if _, ok := c.ghost.remove(key); ok {
+		c.frequent.set(key, value)
+		c.evictIfFull()
+		return
+	}
+
+	c.recent.set(key, value)
+	c.evictIfFull()
+}
+
+// evictIfFull first trims recent back down to its own share of the
+// capacity, ghosting whatever it evicts, then trims frequent if the total
+// is still over the cache's overall capacity.
+func (c *TwoQueueCache) evictIfFull() {
+	for c.recent.len() > c.recent_size {
+		k, _, ok := c.recent.removeOldest()
+		if !ok {
+			break
+		}
+		c.ghost.set(k, nil)
+	}
+	for c.recent.len()+c.frequent.len() > c.size {
+		if _, _, ok := c.frequent.removeOldest(); !ok {
+			break
+		}
+	}
+}
+
+// Len returns the number of items currently cached (recent + frequent).
+func (c *TwoQueueCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.recent.len() + c.frequent.len()
+}
+
+// Clear empties the cache, including the ghost list.
+func (c *TwoQueueCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.recent.clear()
+	c.frequent.clear()
+	c.ghost.clear()
+}
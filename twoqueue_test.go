@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func TestTwoQueueCacheGetSetBasic(t *testing.T) {
+	c := New2QCache(4)
+	c.Set("a", 1)
+
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %v, %v", v, ok)
+	}
+	if c.Len() != 1 {
+		t.Fatalf("expected len 1, got %d", c.Len())
+	}
+}
+
+func TestTwoQueueCacheSecondAccessPromotesToFrequent(t *testing.T) {
+	c := New2QCache(4)
+	c.Set("a", 1)
+
+	if c.frequent.contains("a") {
+		t.Fatal("expected a to start in recent, not frequent")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to be found on first get")
+	}
+	if !c.frequent.contains("a") {
+		t.Fatal("expected a to be promoted to frequent after a second access")
+	}
+}
+
+func TestTwoQueueCacheGhostHitPromotesDirectly(t *testing.T) {
+	c := New2QCache(4)
+	c.recent_size = 1 // force recent evictions quickly
+
+	c.Set("a", 1)
+	c.Set("b", 2) // evicts a from recent into the ghost list
+
+	if !c.ghost.contains("a") {
+		t.Fatal("expected a to be in the ghost list after eviction from recent")
+	}
+
+	c.Set("a", 10) // ghost hit should promote a straight to frequent
+	if !c.frequent.contains("a") {
+		t.Fatal("expected a to be promoted to frequent on ghost hit")
+	}
+	if v, ok := c.Get("a"); !ok || v != 10 {
+		t.Fatalf("expected a=10 after ghost promotion, got %v, %v", v, ok)
+	}
+}
+
+func TestTwoQueueCacheClear(t *testing.T) {
+	c := New2QCache(4)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Clear()
+
+	if c.Len() != 0 {
+		t.Fatalf("expected len 0 after Clear, got %d", c.Len())
+	}
+}